@@ -0,0 +1,233 @@
+package vault
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// awsSTSEndpoint returns the regional STS endpoint to sign and send the
+// login request against. Vault validates the signature using the region it
+// parses out of the request's Authorization header, so this must agree with
+// the region passed to v4.Signer.Sign, or with whatever sts_region/
+// sts_endpoint is configured on the backend's config/client (chunk0-1).
+func awsSTSEndpoint(region string) string {
+	if region == "" {
+		return "https://sts.amazonaws.com/"
+	}
+	return fmt.Sprintf("https://sts.%s.amazonaws.com/", region)
+}
+
+func authLoginAWSSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"token"},
+		Description:   "Login to Vault using the AWS IAM auth method.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"mount": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "aws",
+					Description: "The path where the AWS auth backend is mounted.",
+				},
+				"role": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The Vault role to request a token for.",
+				},
+				"region": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "us-east-1",
+					Description: "The AWS region to sign the sts:GetCallerIdentity request for.",
+				},
+				"header_value": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Value to set the X-Vault-AWS-IAM-Server-ID header to, matching iam_server_id_header_value on the backend's config/client.",
+				},
+				"aws_access_key_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The AWS access key ID to use. Falls back to the ambient AWS credential chain if unset.",
+				},
+				"aws_secret_access_key": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The AWS secret access key to use. Falls back to the ambient AWS credential chain if unset.",
+				},
+				"aws_session_token": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The AWS session token to use.",
+				},
+				"aws_profile": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The AWS profile to use from the shared credentials file.",
+				},
+				"aws_shared_credentials_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path to the AWS shared credentials file to use, if not the default.",
+				},
+				"aws_role_arn": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "An AWS role ARN to assume using the resolved credentials before signing the login request.",
+				},
+			},
+		},
+	}
+}
+
+// awsLoginData is the subset of auth_login_aws fields needed to build and
+// sign the sts:GetCallerIdentity request used to log in to the aws auth
+// method.
+type awsLoginData struct {
+	mount                    string
+	role                     string
+	region                   string
+	headerValue              string
+	awsAccessKeyID           string
+	awsSecretAccessKey       string
+	awsSessionToken          string
+	awsProfile               string
+	awsSharedCredentialsFile string
+	awsRoleARN               string
+}
+
+func awsLoginDataFromResourceData(d map[string]interface{}) *awsLoginData {
+	get := func(k string) string {
+		if v, ok := d[k]; ok {
+			if s, ok := v.(string); ok {
+				return s
+			}
+		}
+		return ""
+	}
+
+	return &awsLoginData{
+		mount:                    get("mount"),
+		role:                     get("role"),
+		region:                   get("region"),
+		headerValue:              get("header_value"),
+		awsAccessKeyID:           get("aws_access_key_id"),
+		awsSecretAccessKey:       get("aws_secret_access_key"),
+		awsSessionToken:          get("aws_session_token"),
+		awsProfile:               get("aws_profile"),
+		awsSharedCredentialsFile: get("aws_shared_credentials_file"),
+		awsRoleARN:               get("aws_role_arn"),
+	}
+}
+
+// awsIAMLogin signs an sts:GetCallerIdentity request with the resolved AWS
+// credentials and exchanges it with Vault for a token via the aws auth
+// method's IAM login path.
+func awsIAMLogin(client *api.Client, l *awsLoginData) (*api.Secret, error) {
+	loginData, err := l.buildLoginData()
+	if err != nil {
+		return nil, fmt.Errorf("error building AWS IAM login request: %s", err)
+	}
+
+	path := "auth/" + strings.Trim(l.mount, "/") + "/login"
+	return client.Logical().Write(path, loginData)
+}
+
+func (l *awsLoginData) buildLoginData() (map[string]interface{}, error) {
+	staticCreds, err := l.credentials()
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		SharedConfigState: session.SharedConfigEnable,
+		Profile:           l.awsProfile,
+		Config: aws.Config{
+			Region:      aws.String(l.region),
+			Credentials: staticCreds,
+		},
+		SharedConfigFiles: l.sharedCredentialsFiles(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %s", err)
+	}
+
+	creds := sess.Config.Credentials
+	if l.awsRoleARN != "" {
+		creds = stscreds.NewCredentials(sess, l.awsRoleARN)
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, awsSTSEndpoint(l.region), strings.NewReader("Action=GetCallerIdentity&Version=2011-06-15"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	if l.headerValue != "" {
+		req.Header.Set("X-Vault-AWS-IAM-Server-ID", l.headerValue)
+	}
+
+	signer := v4.NewSigner(creds)
+	if _, err := signer.Sign(req, strings.NewReader("Action=GetCallerIdentity&Version=2011-06-15"), "sts", l.region, time.Now()); err != nil {
+		return nil, fmt.Errorf("error signing sts:GetCallerIdentity request: %s", err)
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading signed request body: %s", err)
+	}
+
+	headersJSON, err := json.Marshal(req.Header)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling signed request headers: %s", err)
+	}
+
+	data := map[string]interface{}{
+		"role":                    l.role,
+		"iam_http_request_method": req.Method,
+		"iam_request_url":         base64.StdEncoding.EncodeToString([]byte(req.URL.String())),
+		"iam_request_body":        base64.StdEncoding.EncodeToString(body),
+		"iam_request_headers":     base64.StdEncoding.EncodeToString(headersJSON),
+	}
+
+	return data, nil
+}
+
+// credentials builds a static credentials provider from the configured
+// aws_access_key_id/aws_secret_access_key/aws_session_token fields, if any of
+// them were set. Vault's AWS IAM login requires a fully-formed key ID and
+// secret key to sign the sts:GetCallerIdentity request, so a partial set
+// (e.g. only aws_session_token, or only one of the key pair) is rejected
+// rather than silently producing a request that will fail to authenticate.
+// When none of the three are set, nil is returned and the AWS session falls
+// back to its ambient credential chain (env vars, shared config, instance
+// profile, etc).
+func (l *awsLoginData) credentials() (*credentials.Credentials, error) {
+	if l.awsAccessKeyID == "" && l.awsSecretAccessKey == "" && l.awsSessionToken == "" {
+		return nil, nil
+	}
+	if l.awsAccessKeyID == "" || l.awsSecretAccessKey == "" {
+		return nil, fmt.Errorf("aws_access_key_id and aws_secret_access_key must both be set to use static credentials")
+	}
+	return credentials.NewStaticCredentials(l.awsAccessKeyID, l.awsSecretAccessKey, l.awsSessionToken), nil
+}
+
+func (l *awsLoginData) sharedCredentialsFiles() []string {
+	if l.awsSharedCredentialsFile == "" {
+		return nil
+	}
+	return []string{l.awsSharedCredentialsFile}
+}
@@ -0,0 +1,160 @@
+package vault
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestAWSSTSEndpoint(t *testing.T) {
+	tests := []struct {
+		region string
+		want   string
+	}{
+		{region: "", want: "https://sts.amazonaws.com/"},
+		{region: "us-west-2", want: "https://sts.us-west-2.amazonaws.com/"},
+	}
+
+	for _, tt := range tests {
+		if got := awsSTSEndpoint(tt.region); got != tt.want {
+			t.Errorf("awsSTSEndpoint(%q) = %q, want %q", tt.region, got, tt.want)
+		}
+	}
+}
+
+func TestAWSLoginDataBuildLoginData(t *testing.T) {
+	l := &awsLoginData{
+		mount:              "aws",
+		role:               "my-role",
+		region:             "us-west-2",
+		awsAccessKeyID:     "AKIAEXAMPLE",
+		awsSecretAccessKey: "secretkeyexample",
+	}
+
+	data, err := l.buildLoginData()
+	if err != nil {
+		t.Fatalf("buildLoginData() returned error: %s", err)
+	}
+
+	if got, want := data["role"], "my-role"; got != want {
+		t.Errorf("data[role] = %v, want %v", got, want)
+	}
+
+	if got, want := data["iam_http_request_method"], http.MethodPost; got != want {
+		t.Errorf("data[iam_http_request_method] = %v, want %v", got, want)
+	}
+
+	urlBytes, err := base64.StdEncoding.DecodeString(data["iam_request_url"].(string))
+	if err != nil {
+		t.Fatalf("error decoding iam_request_url: %s", err)
+	}
+	if got, want := string(urlBytes), awsSTSEndpoint(l.region); got != want {
+		t.Errorf("iam_request_url = %q, want %q", got, want)
+	}
+
+	bodyBytes, err := base64.StdEncoding.DecodeString(data["iam_request_body"].(string))
+	if err != nil {
+		t.Fatalf("error decoding iam_request_body: %s", err)
+	}
+	if got, want := string(bodyBytes), "Action=GetCallerIdentity&Version=2011-06-15"; got != want {
+		t.Errorf("iam_request_body = %q, want %q", got, want)
+	}
+}
+
+func TestAWSLoginDataBuildLoginDataHeaderValue(t *testing.T) {
+	base := &awsLoginData{
+		mount:              "aws",
+		role:               "my-role",
+		region:             "us-east-1",
+		awsAccessKeyID:     "AKIAEXAMPLE",
+		awsSecretAccessKey: "secretkeyexample",
+	}
+
+	withHeader := *base
+	withHeader.headerValue = "vault.example.com"
+
+	data, err := withHeader.buildLoginData()
+	if err != nil {
+		t.Fatalf("buildLoginData() returned error: %s", err)
+	}
+	headers := decodeIAMRequestHeaders(t, data)
+	if got, want := headers.Get("X-Vault-AWS-IAM-Server-ID"), "vault.example.com"; got != want {
+		t.Errorf("X-Vault-AWS-IAM-Server-ID header = %q, want %q", got, want)
+	}
+
+	data, err = base.buildLoginData()
+	if err != nil {
+		t.Fatalf("buildLoginData() returned error: %s", err)
+	}
+	headers = decodeIAMRequestHeaders(t, data)
+	if got := headers.Get("X-Vault-AWS-IAM-Server-ID"); got != "" {
+		t.Errorf("X-Vault-AWS-IAM-Server-ID header = %q, want empty", got)
+	}
+}
+
+func decodeIAMRequestHeaders(t *testing.T, data map[string]interface{}) http.Header {
+	t.Helper()
+
+	raw, err := base64.StdEncoding.DecodeString(data["iam_request_headers"].(string))
+	if err != nil {
+		t.Fatalf("error decoding iam_request_headers: %s", err)
+	}
+
+	var headers http.Header
+	if err := json.Unmarshal(raw, &headers); err != nil {
+		t.Fatalf("error unmarshaling iam_request_headers: %s", err)
+	}
+	return headers
+}
+
+func TestAWSLoginDataCredentials(t *testing.T) {
+	cases := []struct {
+		name    string
+		data    *awsLoginData
+		wantErr bool
+		wantNil bool
+	}{
+		{
+			name:    "unset falls back to ambient credential chain",
+			data:    &awsLoginData{},
+			wantNil: true,
+		},
+		{
+			name: "access key and secret key set",
+			data: &awsLoginData{
+				awsAccessKeyID:     "AKIAEXAMPLE",
+				awsSecretAccessKey: "secretkeyexample",
+			},
+		},
+		{
+			name: "session token alone is rejected",
+			data: &awsLoginData{
+				awsSessionToken: "sessiontoken",
+			},
+			wantErr: true,
+		},
+		{
+			name: "access key without secret key is rejected",
+			data: &awsLoginData{
+				awsAccessKeyID: "AKIAEXAMPLE",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			creds, err := c.data.credentials()
+			if c.wantErr && err == nil {
+				t.Fatalf("credentials() returned no error, want one")
+			}
+			if !c.wantErr && err != nil {
+				t.Fatalf("credentials() returned error: %s", err)
+			}
+			if c.wantNil && creds != nil {
+				t.Fatalf("credentials() = %v, want nil", creds)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func awsAuthBackendStsRolesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: awsAuthBackendStsRolesDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Unique name of the auth backend to list STS accounts for.",
+				Default:     "aws",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"account_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of AWS account IDs that have a configured STS role.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func awsAuthBackendStsRolesDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := "auth/" + strings.Trim(backend, "/") + "/config/sts"
+
+	log.Printf("[DEBUG] Listing AWS auth backend STS accounts at %q", path)
+	resp, err := client.Logical().List(path)
+	if err != nil {
+		return fmt.Errorf("error listing AWS auth backend STS accounts at %q: %s", path, err)
+	}
+
+	d.SetId(path)
+
+	var accountIDs []string
+	if resp != nil {
+		if keys, ok := resp.Data["keys"].([]interface{}); ok {
+			for _, key := range keys {
+				if accountID, ok := key.(string); ok {
+					accountIDs = append(accountIDs, accountID)
+				}
+			}
+		}
+	}
+
+	if err := d.Set("account_ids", accountIDs); err != nil {
+		return fmt.Errorf("error setting account_ids: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAWSAuthBackendStsRoles(t *testing.T) {
+	backend := acctest.RandomWithPrefix("aws")
+	accountID := "123456789012"
+	stsRole := "arn:aws:iam::123456789012:role/test-role"
+
+	dataName := "data.vault_aws_auth_backend_sts_roles.accounts"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAWSAuthBackendStsRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSAuthBackendStsRolesConfig(backend, accountID, stsRole),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "backend", backend),
+					resource.TestCheckResourceAttr(dataName, "account_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataName, "account_ids.0", accountID),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSAuthBackendStsRolesConfig(backend, accountID, stsRole string) string {
+	return testAccAWSAuthBackendStsRoleConfig(backend, accountID, stsRole) + `
+data "vault_aws_auth_backend_sts_roles" "accounts" {
+  backend = vault_auth_backend.aws.path
+
+  depends_on = [vault_aws_auth_backend_sts_role.role]
+}
+`
+}
@@ -0,0 +1,215 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// identityEntityAliasListPageSize bounds how many alias IDs are requested per
+// LIST call when paging through identity/entity-alias/id.
+const identityEntityAliasListPageSize = 1000
+
+func identityEntityAliasListDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: identityEntityAliasListDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"mount_accessor": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return aliases belonging to this mount accessor.",
+			},
+			"canonical_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return aliases belonging to this entity ID.",
+			},
+			"name_regex": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return aliases whose name matches this regular expression.",
+			},
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Only return aliases whose custom_metadata contains all of these key/value pairs.",
+			},
+			"aliases": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Ordered, deduplicated list of aliases matching the given filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mount_accessor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"canonical_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"custom_metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func identityEntityAliasListDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mountAccessor := d.Get("mount_accessor").(string)
+	canonicalID := d.Get("canonical_id").(string)
+	customMetadataFilter := d.Get("custom_metadata").(map[string]interface{})
+
+	var nameRegex *regexp.Regexp
+	if v := d.Get("name_regex").(string); v != "" {
+		compiled, err := regexp.Compile(v)
+		if err != nil {
+			return fmt.Errorf("invalid name_regex %q: %s", v, err)
+		}
+		nameRegex = compiled
+	}
+
+	ids, err := identityEntityAliasListIDs(client)
+	if err != nil {
+		return fmt.Errorf("error listing identity entity aliases: %s", err)
+	}
+
+	seen := make(map[string]bool, len(ids))
+	aliases := make([]map[string]interface{}, 0, len(ids))
+
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		resp, err := client.Logical().Read(identityEntityAliasIDPath(id))
+		if err != nil {
+			return fmt.Errorf("error reading identity entity alias %q: %s", id, err)
+		}
+		if resp == nil {
+			continue
+		}
+
+		name, _ := resp.Data["name"].(string)
+		aliasMountAccessor, _ := resp.Data["mount_accessor"].(string)
+		aliasCanonicalID, _ := resp.Data["canonical_id"].(string)
+
+		if mountAccessor != "" && aliasMountAccessor != mountAccessor {
+			continue
+		}
+		if canonicalID != "" && aliasCanonicalID != canonicalID {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+
+		customMetadata, _ := resp.Data["custom_metadata"].(map[string]interface{})
+		if !identityEntityAliasMatchesMetadata(customMetadata, customMetadataFilter) {
+			continue
+		}
+
+		aliases = append(aliases, map[string]interface{}{
+			"id":              id,
+			"name":            name,
+			"mount_accessor":  aliasMountAccessor,
+			"canonical_id":    aliasCanonicalID,
+			"custom_metadata": customMetadata,
+		})
+	}
+
+	// Keep the result order stable across reads regardless of the order Vault
+	// returns keys in, so it's usable as for_each input.
+	sort.Slice(aliases, func(i, j int) bool {
+		return aliases[i]["id"].(string) < aliases[j]["id"].(string)
+	})
+
+	d.SetId(identityEntityAliasPath + "/id")
+
+	if err := d.Set("aliases", aliases); err != nil {
+		return fmt.Errorf("error setting aliases: %s", err)
+	}
+
+	return nil
+}
+
+func identityEntityAliasMatchesMetadata(actual map[string]interface{}, filter map[string]interface{}) bool {
+	for k, v := range filter {
+		actualValue, ok := actual[k]
+		if !ok || fmt.Sprintf("%v", actualValue) != fmt.Sprintf("%v", v) {
+			return false
+		}
+	}
+	return true
+}
+
+// identityEntityAliasListIDs pages through identity/entity-alias/id?list=true,
+// collecting every alias ID across all pages.
+func identityEntityAliasListIDs(client *api.Client) ([]string, error) {
+	var ids []string
+	after := ""
+
+	for {
+		params := map[string][]string{
+			"list":  {"true"},
+			"limit": {fmt.Sprintf("%d", identityEntityAliasListPageSize)},
+		}
+		if after != "" {
+			params["after"] = []string{after}
+		}
+
+		resp, err := client.Logical().ReadWithData(identityEntityAliasPath+"/id", params)
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil || resp.Data["keys"] == nil {
+			break
+		}
+
+		keys, ok := resp.Data["keys"].([]interface{})
+		if !ok || len(keys) == 0 {
+			break
+		}
+
+		for _, key := range keys {
+			if id, ok := key.(string); ok {
+				ids = append(ids, id)
+			}
+		}
+
+		if len(keys) < identityEntityAliasListPageSize {
+			break
+		}
+
+		last, ok := keys[len(keys)-1].(string)
+		if !ok || last == after {
+			break
+		}
+		after = last
+	}
+
+	log.Printf("[DEBUG] Listed %d identity entity alias(es)", len(ids))
+
+	return ids, nil
+}
@@ -0,0 +1,43 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIdentityEntityAliasList(t *testing.T) {
+	entity := acctest.RandomWithPrefix("my-entity")
+
+	dataName := "data.vault_identity_entity_alias_list.aliases"
+	nameEntity := "vault_identity_entity.entityA"
+	nameGithubA := "vault_auth_backend.githubA"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityEntityAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIdentityEntityAliasListConfig(entity),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "aliases.#", "1"),
+					resource.TestCheckResourceAttrPair(dataName, "aliases.0.name", nameEntity, "name"),
+					resource.TestCheckResourceAttrPair(dataName, "aliases.0.canonical_id", nameEntity, "id"),
+					resource.TestCheckResourceAttrPair(dataName, "aliases.0.mount_accessor", nameGithubA, "accessor"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceIdentityEntityAliasListConfig(entityName string) string {
+	return testAccIdentityEntityAliasConfig(entityName, false, false) + `
+data "vault_identity_entity_alias_list" "aliases" {
+  mount_accessor = vault_auth_backend.githubA.accessor
+
+  depends_on = [vault_identity_entity_alias.entity-alias]
+}
+`
+}
@@ -0,0 +1,82 @@
+package vault
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func Provider() terraform.ResourceProvider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VAULT_ADDR", nil),
+				Description: "URL of the root of the target Vault server.",
+			},
+			"token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("VAULT_TOKEN", ""),
+				Description:   "Token to use to authenticate to Vault.",
+				ConflictsWith: []string{"auth_login_aws"},
+			},
+			"auth_login_aws": authLoginAWSSchema(),
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"vault_aws_auth_backend_config_identity":                awsAuthBackendConfigIdentityResource(),
+			"vault_aws_auth_backend_config_client":                  awsAuthBackendConfigClientResource(),
+			"vault_aws_auth_backend_sts_role":                       awsAuthBackendStsRoleResource(),
+			"vault_aws_auth_backend_config_tidy_roletag_blacklist":  awsAuthBackendConfigTidyRoletagBlacklistResource(),
+			"vault_aws_auth_backend_config_tidy_identity_whitelist": awsAuthBackendConfigTidyIdentityWhitelistResource(),
+			"vault_identity_entity_alias":                           identityEntityAliasResource(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"vault_aws_auth_backend_sts_roles": awsAuthBackendStsRolesDataSource(),
+			"vault_identity_entity_alias_list": identityEntityAliasListDataSource(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	config := api.DefaultConfig()
+	config.Address = d.Get("address").(string)
+
+	client, err := api.NewClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("error configuring Vault client: %s", err)
+	}
+
+	token := d.Get("token").(string)
+
+	if v, ok := d.GetOk("auth_login_aws"); ok {
+		awsBlocks := v.([]interface{})
+		if len(awsBlocks) == 1 && awsBlocks[0] != nil {
+			loginData := awsLoginDataFromResourceData(awsBlocks[0].(map[string]interface{}))
+
+			secret, err := awsIAMLogin(client, loginData)
+			if err != nil {
+				return nil, fmt.Errorf("error logging in via auth_login_aws: %s", err)
+			}
+			if secret == nil || secret.Auth == nil {
+				return nil, fmt.Errorf("error logging in via auth_login_aws: no auth info returned")
+			}
+			token = secret.Auth.ClientToken
+		}
+	}
+
+	if token == "" {
+		return nil, fmt.Errorf("no vault token found; set the token provider argument, VAULT_TOKEN, or auth_login_aws")
+	}
+
+	client.SetToken(token)
+
+	return client, nil
+}
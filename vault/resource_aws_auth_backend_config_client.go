@@ -0,0 +1,216 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	awsAuthBackendConfigClientBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/config/client$")
+)
+
+func awsAuthBackendConfigClientResource() *schema.Resource {
+	return &schema.Resource{
+		Create: awsAuthBackendConfigClientWrite,
+		Update: awsAuthBackendConfigClientWrite,
+		Read:   awsAuthBackendConfigClientRead,
+		Delete: awsAuthBackendConfigClientDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Unique name of the auth backend to configure.",
+				ForceNew:    true,
+				Default:     "aws",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "AWS Access key with permissions to query AWS APIs.",
+			},
+			// Sensitive marks this write-only in Terraform's UI/plan output, but
+			// terraform-plugin-sdk/v2 has no way to keep it out of state entirely;
+			// true write-only (ephemeral) arguments are a plugin-framework/protocol
+			// v6 feature that isn't available to SDK v2 resources like this one.
+			"secret_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "AWS Secret key with permissions to query AWS APIs.",
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL to override the default generated endpoint for making AWS EC2 API calls.",
+			},
+			"iam_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL to override the default generated endpoint for making AWS IAM API calls.",
+			},
+			"sts_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL to override the default generated endpoint for making AWS STS API calls.",
+			},
+			"sts_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Region to override the default region for making AWS STS API calls.",
+			},
+			"iam_server_id_header_value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The value to require in the X-Vault-AWS-IAM-Server-ID header as part of GetCallerIdentity requests.",
+			},
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     -1,
+				Description: "Number of max retries the client should use for recoverable exceptions.",
+			},
+			"use_sts_region_from_client": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If set, will override sts_region and use the region from the client request's signature.",
+			},
+			"allowed_sts_header_values": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of additional headers that are allowed to be process when the iam_server_id_header_value is configured.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func awsAuthBackendConfigClientWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := awsAuthBackendConfigClientPath(backend)
+
+	data := map[string]interface{}{
+		"access_key":                 d.Get("access_key").(string),
+		"secret_key":                 d.Get("secret_key").(string),
+		"endpoint":                   d.Get("endpoint").(string),
+		"iam_endpoint":               d.Get("iam_endpoint").(string),
+		"sts_endpoint":               d.Get("sts_endpoint").(string),
+		"sts_region":                 d.Get("sts_region").(string),
+		"iam_server_id_header_value": d.Get("iam_server_id_header_value").(string),
+		"max_retries":                d.Get("max_retries").(int),
+		"use_sts_region_from_client": d.Get("use_sts_region_from_client").(bool),
+	}
+
+	if allowedHeadersConfig, ok := d.GetOk("allowed_sts_header_values"); ok {
+		data["allowed_sts_header_values"] = util.TerraformSetToStringArray(allowedHeadersConfig)
+	}
+
+	log.Printf("[DEBUG] Writing AWS auth backend client config to %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error configuring AWS auth backend client config %q: %s", path, err)
+	}
+	d.SetId(path)
+
+	log.Printf("[DEBUG] Wrote AWS auth backend client config to %q", path)
+
+	return awsAuthBackendConfigClientRead(d, meta)
+}
+
+func awsAuthBackendConfigClientRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := awsAuthBackendConfigClientBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for AWS auth backend client config: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading client config %q from AWS auth backend", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading AWS auth backend client config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read client config %q from AWS auth backend", path)
+	if resp == nil {
+		log.Printf("[WARN] AWS auth backend client config %q not found, removing it from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	// secret_key is never returned by Vault, so we leave it alone in state.
+	d.Set("access_key", resp.Data["access_key"])
+	d.Set("endpoint", resp.Data["endpoint"])
+	d.Set("iam_endpoint", resp.Data["iam_endpoint"])
+	d.Set("sts_endpoint", resp.Data["sts_endpoint"])
+	d.Set("sts_region", resp.Data["sts_region"])
+	d.Set("iam_server_id_header_value", resp.Data["iam_server_id_header_value"])
+	d.Set("max_retries", resp.Data["max_retries"])
+	d.Set("use_sts_region_from_client", resp.Data["use_sts_region_from_client"])
+	d.Set("allowed_sts_header_values", resp.Data["allowed_sts_header_values"])
+	d.Set("backend", backend)
+
+	return nil
+}
+
+func awsAuthBackendConfigClientDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting AWS auth backend client config %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting AWS auth backend client config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted AWS auth backend client config %q", path)
+
+	return nil
+}
+
+func awsAuthBackendConfigClientExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Checking if client config %q exists in AWS auth backend", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking for existence of AWS auth backend client config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if client config %q exists in AWS auth backend", path)
+	return resp != nil, nil
+}
+
+func awsAuthBackendConfigClientPath(backend string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/config/client"
+}
+
+func awsAuthBackendConfigClientBackendFromPath(path string) (string, error) {
+	if !awsAuthBackendConfigClientBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := awsAuthBackendConfigClientBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,76 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccAWSAuthBackendConfigClient(t *testing.T) {
+	backend := acctest.RandomWithPrefix("aws")
+	resourceName := "vault_aws_auth_backend_config_client.client"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAWSAuthBackendConfigClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAuthBackendConfigClientConfig(backend, "us-east-1", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "sts_region", "us-east-1"),
+					resource.TestCheckResourceAttr(resourceName, "use_sts_region_from_client", "false"),
+					resource.TestCheckResourceAttr(resourceName, "max_retries", "3"),
+				),
+			},
+			{
+				Config: testAccAWSAuthBackendConfigClientConfig(backend, "us-west-2", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "sts_region", "us-west-2"),
+					resource.TestCheckResourceAttr(resourceName, "use_sts_region_from_client", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAuthBackendConfigClientDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_aws_auth_backend_config_client" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for AWS auth backend client config %q: %s", rs.Primary.ID, err)
+		}
+		if secret != nil {
+			return fmt.Errorf("AWS auth backend client config %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccAWSAuthBackendConfigClientConfig(backend, stsRegion string, useSTSRegionFromClient bool) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "aws" {
+  type = "aws"
+  path = "%s"
+}
+
+resource "vault_aws_auth_backend_config_client" "client" {
+  backend                    = vault_auth_backend.aws.path
+  sts_region                 = "%s"
+  use_sts_region_from_client = %t
+  iam_server_id_header_value = "vault.example.com"
+  max_retries                = 3
+  allowed_sts_header_values  = ["X-Consul-IAM-ServerID"]
+}
+`, backend, stsRegion, useSTSRegionFromClient)
+}
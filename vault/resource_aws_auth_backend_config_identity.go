@@ -23,7 +23,15 @@ func awsAuthBackendConfigIdentityResource() *schema.Resource {
 		Read:   awsAuthBackendConfigIdentityRead,
 		Delete: awsAuthBackendConfigIdentityDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			// Imported resources default destroy_resets_to_defaults to false so that
+			// running `terraform destroy` on a config this resource didn't create
+			// doesn't unexpectedly reset config/identity on the backend.
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := d.Set("destroy_resets_to_defaults", false); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,6 +76,12 @@ func awsAuthBackendConfigIdentityResource() *schema.Resource {
 					return strings.Trim(v.(string), "/")
 				},
 			},
+			"destroy_resets_to_defaults": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If set, config/identity will be reset to its default values on destroy. Defaults to false for resources imported with terraform import, to preserve pre-existing config when the module managing it is removed.",
+			},
 		},
 	}
 }
@@ -141,7 +155,28 @@ func awsAuthBackendConfigIdentityRead(d *schema.ResourceData, meta interface{})
 }
 
 func awsAuthBackendConfigIdentityDelete(d *schema.ResourceData, meta interface{}) error {
-	log.Printf("[DEBUG] Deleting AWS identity config from state file")
+	if !d.Get("destroy_resets_to_defaults").(bool) {
+		log.Printf("[DEBUG] Removing AWS identity config %q from state without resetting Vault defaults", d.Id())
+		return nil
+	}
+
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	data := map[string]interface{}{
+		"iam_alias":    "role_id",
+		"iam_metadata": []string{},
+		"ec2_alias":    "role_id",
+		"ec2_metadata": []string{},
+	}
+
+	log.Printf("[DEBUG] Resetting AWS identity config %q to defaults", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error resetting AWS auth identity config %q to defaults: %s", path, err)
+	}
+	log.Printf("[DEBUG] Reset AWS identity config %q to defaults", path)
+
 	return nil
 }
 
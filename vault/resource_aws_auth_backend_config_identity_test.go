@@ -0,0 +1,86 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+// TestAccAWSAuthBackendConfigIdentity_destroyResetsToDefaults confirms that
+// destroying vault_aws_auth_backend_config_identity resets config/identity
+// to Vault's defaults instead of leaving the previously-configured aliases
+// in place.
+func TestAccAWSAuthBackendConfigIdentity_destroyResetsToDefaults(t *testing.T) {
+	backend := acctest.RandomWithPrefix("aws")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAuthBackendConfigIdentityConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_config_identity.identity", "iam_alias", "unique_id"),
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_config_identity.identity", "ec2_alias", "unique_id"),
+				),
+			},
+			{
+				// Removing the resource from config destroys it, which should reset
+				// config/identity on the backend to Vault's defaults.
+				Config: testAccAWSAuthBackendConfigIdentityConfig_backendOnly(backend),
+				Check:  testAccCheckAWSAuthBackendConfigIdentityAtDefaults(backend),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAuthBackendConfigIdentityAtDefaults(backend string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+		path := awsAuthBackendConfigIdentityPath(backend)
+
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return fmt.Errorf("error reading AWS auth identity config %q: %s", path, err)
+		}
+		if resp == nil {
+			return fmt.Errorf("AWS auth identity config %q not found after destroy", path)
+		}
+		if resp.Data["iam_alias"] != "role_id" {
+			return fmt.Errorf("expected iam_alias to be reset to role_id after destroy, got %v", resp.Data["iam_alias"])
+		}
+		if resp.Data["ec2_alias"] != "role_id" {
+			return fmt.Errorf("expected ec2_alias to be reset to role_id after destroy, got %v", resp.Data["ec2_alias"])
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSAuthBackendConfigIdentityConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "aws" {
+  type = "aws"
+  path = "%s"
+}
+
+resource "vault_aws_auth_backend_config_identity" "identity" {
+  backend   = vault_auth_backend.aws.path
+  iam_alias = "unique_id"
+  ec2_alias = "unique_id"
+}
+`, backend)
+}
+
+func testAccAWSAuthBackendConfigIdentityConfig_backendOnly(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "aws" {
+  type = "aws"
+  path = "%s"
+}
+`, backend)
+}
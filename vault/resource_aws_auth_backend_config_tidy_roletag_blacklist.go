@@ -0,0 +1,148 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	awsAuthBackendConfigTidyRoletagBlacklistBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/config/tidy/roletag-blacklist$")
+)
+
+func awsAuthBackendConfigTidyRoletagBlacklistResource() *schema.Resource {
+	return &schema.Resource{
+		Create: awsAuthBackendConfigTidyRoletagBlacklistWrite,
+		Update: awsAuthBackendConfigTidyRoletagBlacklistWrite,
+		Read:   awsAuthBackendConfigTidyRoletagBlacklistRead,
+		Delete: awsAuthBackendConfigTidyRoletagBlacklistDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Unique name of the auth backend to configure.",
+				Default:     "aws",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"safety_buffer": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     259200,
+				Description: "The amount of extra time, in seconds, that must have passed beyond the roletag expiration, before it is removed from the backend storage.",
+			},
+			"disable_periodic_tidy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If set to true, disables the periodic tidying of the roletag blacklist entries.",
+			},
+		},
+	}
+}
+
+func awsAuthBackendConfigTidyRoletagBlacklistWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := awsAuthBackendConfigTidyRoletagBlacklistPath(backend)
+
+	data := map[string]interface{}{
+		"safety_buffer":         d.Get("safety_buffer").(int),
+		"disable_periodic_tidy": d.Get("disable_periodic_tidy").(bool),
+	}
+
+	log.Printf("[DEBUG] Writing AWS auth backend roletag blacklist tidy config to %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error configuring AWS auth backend roletag blacklist tidy config %q: %s", path, err)
+	}
+	d.SetId(path)
+
+	log.Printf("[DEBUG] Wrote AWS auth backend roletag blacklist tidy config to %q", path)
+
+	return awsAuthBackendConfigTidyRoletagBlacklistRead(d, meta)
+}
+
+func awsAuthBackendConfigTidyRoletagBlacklistRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := awsAuthBackendConfigTidyRoletagBlacklistBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for AWS auth backend roletag blacklist tidy config: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading roletag blacklist tidy config %q from AWS auth backend", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading AWS auth backend roletag blacklist tidy config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read roletag blacklist tidy config %q from AWS auth backend", path)
+	if resp == nil {
+		log.Printf("[WARN] AWS auth backend roletag blacklist tidy config %q not found, removing it from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("safety_buffer", resp.Data["safety_buffer"])
+	d.Set("disable_periodic_tidy", resp.Data["disable_periodic_tidy"])
+	d.Set("backend", backend)
+
+	return nil
+}
+
+func awsAuthBackendConfigTidyRoletagBlacklistDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting AWS auth backend roletag blacklist tidy config %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting AWS auth backend roletag blacklist tidy config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted AWS auth backend roletag blacklist tidy config %q", path)
+
+	return nil
+}
+
+func awsAuthBackendConfigTidyRoletagBlacklistExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Checking if roletag blacklist tidy config %q exists in AWS auth backend", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking for existence of AWS auth backend roletag blacklist tidy config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if roletag blacklist tidy config %q exists in AWS auth backend", path)
+	return resp != nil, nil
+}
+
+func awsAuthBackendConfigTidyRoletagBlacklistPath(backend string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/config/tidy/roletag-blacklist"
+}
+
+func awsAuthBackendConfigTidyRoletagBlacklistBackendFromPath(path string) (string, error) {
+	if !awsAuthBackendConfigTidyRoletagBlacklistBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := awsAuthBackendConfigTidyRoletagBlacklistBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
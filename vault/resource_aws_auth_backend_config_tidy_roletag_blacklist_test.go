@@ -0,0 +1,72 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccAWSAuthBackendConfigTidyRoletagBlacklist(t *testing.T) {
+	backend := acctest.RandomWithPrefix("aws")
+	resourceName := "vault_aws_auth_backend_config_tidy_roletag_blacklist.tidy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAWSAuthBackendConfigTidyRoletagBlacklistDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAuthBackendConfigTidyRoletagBlacklistConfig(backend, 43200, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "safety_buffer", "43200"),
+					resource.TestCheckResourceAttr(resourceName, "disable_periodic_tidy", "false"),
+				),
+			},
+			{
+				Config: testAccAWSAuthBackendConfigTidyRoletagBlacklistConfig(backend, 86400, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "safety_buffer", "86400"),
+					resource.TestCheckResourceAttr(resourceName, "disable_periodic_tidy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAuthBackendConfigTidyRoletagBlacklistDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_aws_auth_backend_config_tidy_roletag_blacklist" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for AWS auth backend roletag blacklist tidy config %q: %s", rs.Primary.ID, err)
+		}
+		if secret != nil {
+			return fmt.Errorf("AWS auth backend roletag blacklist tidy config %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccAWSAuthBackendConfigTidyRoletagBlacklistConfig(backend string, safetyBuffer int, disablePeriodicTidy bool) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "aws" {
+  type = "aws"
+  path = "%s"
+}
+
+resource "vault_aws_auth_backend_config_tidy_roletag_blacklist" "tidy" {
+  backend               = vault_auth_backend.aws.path
+  safety_buffer         = %d
+  disable_periodic_tidy = %t
+}
+`, backend, safetyBuffer, disablePeriodicTidy)
+}
@@ -0,0 +1,175 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	awsAuthBackendStsRoleBackendFromPathRegex   = regexp.MustCompile("^auth/(.+)/config/sts/.+$")
+	awsAuthBackendStsRoleAccountIdFromPathRegex = regexp.MustCompile("^auth/.+/config/sts/(.+)$")
+)
+
+func awsAuthBackendStsRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: awsAuthBackendStsRoleWrite,
+		Update: awsAuthBackendStsRoleWrite,
+		Read:   awsAuthBackendStsRoleRead,
+		Delete: awsAuthBackendStsRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Unique name of the auth backend to configure.",
+				Default:     "aws",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "AWS account ID to be associated with STS role.",
+			},
+			"sts_role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "AWS ARN for STS role to be assumed when interacting with the account specified.",
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "External ID expected by the STS role assumption.",
+			},
+		},
+	}
+}
+
+func awsAuthBackendStsRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	accountID := d.Get("account_id").(string)
+
+	path := awsAuthBackendStsRolePath(backend, accountID)
+	data := map[string]interface{}{
+		"sts_role": d.Get("sts_role").(string),
+	}
+
+	if externalID, ok := d.GetOk("external_id"); ok {
+		data["external_id"] = externalID.(string)
+	}
+
+	log.Printf("[DEBUG] Writing AWS auth backend STS role %q", path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error configuring AWS auth backend STS role %q: %s", path, err)
+	}
+	d.SetId(path)
+
+	log.Printf("[DEBUG] Wrote AWS auth backend STS role %q", path)
+
+	return awsAuthBackendStsRoleRead(d, meta)
+}
+
+func awsAuthBackendStsRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := awsAuthBackendStsRoleBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for AWS auth backend STS role: %s", path, err)
+	}
+
+	accountID, err := awsAuthBackendStsRoleAccountIdFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for AWS auth backend STS role: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading AWS auth backend STS role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading AWS auth backend STS role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read AWS auth backend STS role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] AWS auth backend STS role %q not found, removing it from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("sts_role", resp.Data["sts_role"])
+	d.Set("external_id", resp.Data["external_id"])
+	d.Set("backend", backend)
+	d.Set("account_id", accountID)
+
+	return nil
+}
+
+func awsAuthBackendStsRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting AWS auth backend STS role %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting AWS auth backend STS role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted AWS auth backend STS role %q", path)
+
+	return nil
+}
+
+func awsAuthBackendStsRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Checking if AWS auth backend STS role %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking for existence of AWS auth backend STS role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked if AWS auth backend STS role %q exists", path)
+	return resp != nil, nil
+}
+
+func awsAuthBackendStsRolePath(backend, accountID string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/config/sts/" + strings.Trim(accountID, "/")
+}
+
+func awsAuthBackendStsRoleBackendFromPath(path string) (string, error) {
+	if !awsAuthBackendStsRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := awsAuthBackendStsRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
+
+func awsAuthBackendStsRoleAccountIdFromPath(path string) (string, error) {
+	if !awsAuthBackendStsRoleAccountIdFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no account id found")
+	}
+	res := awsAuthBackendStsRoleAccountIdFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for account id", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,236 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func identityEntityAliasResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityEntityAliasCreate,
+		Update: identityEntityAliasUpdate,
+		Read:   identityEntityAliasRead,
+		Delete: identityEntityAliasDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the alias.",
+			},
+			"mount_accessor": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Mount accessor to which this alias belongs to.",
+			},
+			"canonical_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Entity ID to which this alias belongs to.",
+			},
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Custom metadata to be associated with the alias.",
+			},
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If set to true, this resource will adopt an existing alias with a matching " +
+					"name and mount_accessor instead of failing when Vault reports that the alias already exists. " +
+					"The existing alias is only adopted when its canonical_id matches the configured entity.",
+			},
+		},
+	}
+}
+
+func identityEntityAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	mountAccessor := d.Get("mount_accessor").(string)
+	canonicalID := d.Get("canonical_id").(string)
+
+	path := identityEntityAliasPath
+	data := map[string]interface{}{
+		"name":           name,
+		"mount_accessor": mountAccessor,
+		"canonical_id":   canonicalID,
+	}
+
+	if v, ok := d.GetOk("custom_metadata"); ok {
+		data["custom_metadata"] = v
+	}
+
+	log.Printf("[DEBUG] Writing IdentityEntityAlias %q", name)
+	resp, err := client.Logical().Write(path, data)
+	if err == nil && resp == nil {
+		// Vault returns no response when an alias with this name and mount
+		// accessor already exists.
+		err = fmt.Errorf("IdentityEntityAlias %q already exists for mount accessor %q; it may be imported", name, mountAccessor)
+	}
+	if err != nil {
+		if d.Get("adopt_existing").(bool) && isIdentityEntityAliasAlreadyExistsError(err) {
+			return identityEntityAliasAdopt(d, meta, name, mountAccessor, canonicalID, data)
+		}
+		return fmt.Errorf("error writing IdentityEntityAlias %q: %s", name, err)
+	}
+	if resp == nil || resp.Data["id"] == nil {
+		return fmt.Errorf("identity entity alias ID not found in response")
+	}
+
+	d.SetId(resp.Data["id"].(string))
+
+	return identityEntityAliasRead(d, meta)
+}
+
+// identityEntityAliasAdopt handles the adopt_existing = true fallback: the alias
+// already exists in Vault but isn't tracked by this resource, so we look it up by
+// (name, mount_accessor), confirm it belongs to the configured entity, and bring
+// it under management with an update instead of silently stealing it.
+func identityEntityAliasAdopt(d *schema.ResourceData, meta interface{}, name, mountAccessor, canonicalID string, data map[string]interface{}) error {
+	client := meta.(*api.Client)
+
+	existing, err := identityEntityAliasFindByNameAndAccessor(client, name, mountAccessor)
+	if err != nil {
+		return fmt.Errorf("error finding existing IdentityEntityAlias %q to adopt: %s", name, err)
+	}
+	if existing == nil {
+		return fmt.Errorf("IdentityEntityAlias %q already exists but could not be found for adoption", name)
+	}
+
+	existingCanonicalID, _ := existing["canonical_id"].(string)
+	if existingCanonicalID != canonicalID {
+		return fmt.Errorf("found an existing IdentityEntityAlias %q owned by entity %q, not %q; refusing to adopt it", name, existingCanonicalID, canonicalID)
+	}
+
+	id, ok := existing["id"].(string)
+	if !ok || id == "" {
+		return fmt.Errorf("existing IdentityEntityAlias %q has no id", name)
+	}
+
+	log.Printf("[DEBUG] Adopting existing IdentityEntityAlias %q (id=%q)", name, id)
+	if _, err := client.Logical().Write(identityEntityAliasIDPath(id), data); err != nil {
+		return fmt.Errorf("error updating adopted IdentityEntityAlias %q: %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return identityEntityAliasRead(d, meta)
+}
+
+func identityEntityAliasFindByNameAndAccessor(client *api.Client, name, mountAccessor string) (map[string]interface{}, error) {
+	resp, err := client.Logical().List(identityEntityAliasPath + "/id")
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || resp.Data["keys"] == nil {
+		return nil, nil
+	}
+
+	for _, key := range resp.Data["keys"].([]interface{}) {
+		id, ok := key.(string)
+		if !ok {
+			continue
+		}
+
+		aliasResp, err := client.Logical().Read(identityEntityAliasIDPath(id))
+		if err != nil {
+			return nil, err
+		}
+		if aliasResp == nil {
+			continue
+		}
+		if aliasResp.Data["name"] == name && aliasResp.Data["mount_accessor"] == mountAccessor {
+			return aliasResp.Data, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func isIdentityEntityAliasAlreadyExistsError(err error) bool {
+	return err != nil && identityEntityAliasAlreadyExistsRegex.MatchString(err.Error())
+}
+
+func identityEntityAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+	path := identityEntityAliasIDPath(id)
+
+	data := map[string]interface{}{
+		"name":           d.Get("name").(string),
+		"mount_accessor": d.Get("mount_accessor").(string),
+		"canonical_id":   d.Get("canonical_id").(string),
+	}
+
+	if v, ok := d.GetOk("custom_metadata"); ok {
+		data["custom_metadata"] = v
+	}
+
+	log.Printf("[DEBUG] Updating IdentityEntityAlias %q", id)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error updating IdentityEntityAlias %q: %s", id, err)
+	}
+
+	return identityEntityAliasRead(d, meta)
+}
+
+func identityEntityAliasRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+	path := identityEntityAliasIDPath(id)
+
+	log.Printf("[DEBUG] Reading IdentityEntityAlias %q", id)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading IdentityEntityAlias %q: %s", id, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] IdentityEntityAlias %q not found, removing it from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", resp.Data["name"])
+	d.Set("mount_accessor", resp.Data["mount_accessor"])
+	d.Set("canonical_id", resp.Data["canonical_id"])
+	d.Set("custom_metadata", resp.Data["custom_metadata"])
+
+	return nil
+}
+
+func identityEntityAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+	path := identityEntityAliasIDPath(id)
+
+	log.Printf("[DEBUG] Deleting IdentityEntityAlias %q", id)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting IdentityEntityAlias %q: %s", id, err)
+	}
+
+	return nil
+}
+
+var identityEntityAliasAlreadyExistsRegex = regexp.MustCompile(`IdentityEntityAlias.*already exists.*may be imported`)
+
+const identityEntityAliasPath = "identity/entity-alias"
+
+func identityEntityAliasIDPath(id string) string {
+	return identityEntityAliasPath + "/id/" + id
+}
@@ -76,6 +76,133 @@ func TestAccIdentityEntityAlias_Update(t *testing.T) {
 	})
 }
 
+func TestAccIdentityEntityAlias_AdoptExisting(t *testing.T) {
+	entity := acctest.RandomWithPrefix("my-entity")
+
+	nameEntityA := "vault_identity_entity.entityA"
+	nameGithubA := "vault_auth_backend.githubA"
+	nameEntityAlias := "vault_identity_entity_alias.entity-alias-adopted"
+
+	var preExistingID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityEntityAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Create the entity and mount, then create the alias directly against
+				// the Vault API to simulate one that pre-dates this provider's management.
+				Config: testAccIdentityEntityAliasConfig_adoptSetup(entity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityEntityAliasCreateOutOfBand(nameEntityA, nameGithubA, &preExistingID),
+				),
+			},
+			{
+				Config: testAccIdentityEntityAliasConfig_adopt(entity, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(nameEntityAlias, "name", nameEntityA, "name"),
+					resource.TestCheckResourceAttrPair(nameEntityAlias, "canonical_id", nameEntityA, "id"),
+					testAccIdentityEntityAliasCheckSameID(nameEntityAlias, &preExistingID),
+				),
+			},
+		},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityEntityAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityEntityAliasConfig_adoptSetup(entity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityEntityAliasCreateOutOfBand(nameEntityA, nameGithubA, &preExistingID),
+				),
+			},
+			{
+				// entityB doesn't own the pre-existing alias, so adoption must be refused.
+				Config:      testAccIdentityEntityAliasConfig_adopt(entity, true),
+				ExpectError: regexp.MustCompile(`refusing to adopt`),
+			},
+		},
+	})
+}
+
+func testAccIdentityEntityAliasCreateOutOfBand(entityResource, mountResource string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		entityRS, ok := s.RootModule().Resources[entityResource]
+		if !ok {
+			return fmt.Errorf("entity resource %q not found in state", entityResource)
+		}
+		mountRS, ok := s.RootModule().Resources[mountResource]
+		if !ok {
+			return fmt.Errorf("mount resource %q not found in state", mountResource)
+		}
+
+		client := testProvider.Meta().(*api.Client)
+		resp, err := client.Logical().Write(identityEntityAliasPath, map[string]interface{}{
+			"name":           entityRS.Primary.Attributes["name"],
+			"mount_accessor": mountRS.Primary.Attributes["accessor"],
+			"canonical_id":   entityRS.Primary.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("error creating out-of-band identity entity alias: %s", err)
+		}
+
+		*id = resp.Data["id"].(string)
+		return nil
+	}
+}
+
+func testAccIdentityEntityAliasCheckSameID(resourceName string, expected *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+		if rs.Primary.ID != *expected {
+			return fmt.Errorf("expected adopted alias to keep id %q, got %q", *expected, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccIdentityEntityAliasConfig_adoptSetup(entityName string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_entity" "entityA" {
+  name = "%s-A"
+  policies = ["test"]
+}
+
+resource "vault_identity_entity" "entityB" {
+  name = "%s-B"
+  policies = ["test"]
+}
+
+resource "vault_auth_backend" "githubA" {
+  type = "github"
+  path = "githubA-%s"
+}
+`, entityName, entityName, entityName)
+}
+
+func testAccIdentityEntityAliasConfig_adopt(entityName string, mismatchedCanonicalID bool) string {
+	canonicalIDRef := "vault_identity_entity.entityA.id"
+	if mismatchedCanonicalID {
+		canonicalIDRef = "vault_identity_entity.entityB.id"
+	}
+
+	return testAccIdentityEntityAliasConfig_adoptSetup(entityName) + fmt.Sprintf(`
+resource "vault_identity_entity_alias" "entity-alias-adopted" {
+  name           = vault_identity_entity.entityA.name
+  mount_accessor = vault_auth_backend.githubA.accessor
+  canonical_id   = %s
+  adopt_existing = true
+}
+`, canonicalIDRef)
+}
+
 func testAccCheckIdentityEntityAliasDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 